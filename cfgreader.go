@@ -2,13 +2,15 @@ package cfgreader
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"log/slog"
 	"maps"
-	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -20,6 +22,10 @@ const (
 	FormatUnknown ConfigFormat = iota
 	FormatYAML
 	FormatJSON
+	FormatTOML
+	FormatHCL
+	FormatINI
+	FormatDotEnv
 
 	DefaultFormatsCount
 )
@@ -34,21 +40,50 @@ type FormatData struct {
 
 type FormatMap map[ConfigFormat]FormatData
 
+// DefaultFormats lists every format ConfigReader knows the name and extensions
+// for. YAML and JSON are always decodable. TOML, HCL, INI and dotenv ship
+// their Unmarshal implementation in separate files guarded by the "toml",
+// "hcl", "ini" and "dotenv" build tags respectively, so a binary built
+// without those tags stays free of the extra dependencies and simply fails
+// with a "no decoder registered" error if one of those extensions is
+// encountered.
 var DefaultFormats = FormatMap{
 	FormatUnknown: {Name: "unknown", Extensions: []string{""}},
 	FormatYAML:    {Name: "yaml", Extensions: []string{".yaml", ".yml"}, Unmarshal: yaml.Unmarshal},
 	FormatJSON:    {Name: "json", Extensions: []string{".json"}, Unmarshal: json.Unmarshal},
+	FormatTOML:    {Name: "toml", Extensions: []string{".toml"}},
+	FormatHCL:     {Name: "hcl", Extensions: []string{".hcl", ".tf"}},
+	FormatINI:     {Name: "ini", Extensions: []string{".ini"}},
+	FormatDotEnv:  {Name: "dotenv", Extensions: []string{".env"}},
+}
+
+// registerBuiltinUnmarshal wires a decoder into DefaultFormats for a format
+// whose implementation lives behind a build tag. It is called from the
+// init() function of each optional format_*.go file.
+func registerBuiltinUnmarshal(format ConfigFormat, fn UnmarshalFunc) {
+	data := DefaultFormats[format]
+	data.Unmarshal = fn
+	DefaultFormats[format] = data
 }
 
 // ConfigReader handles reading and parsing configuration files with generics
 type ConfigReader[T any] struct {
-	logger        *slog.Logger
-	defaultPath   string
-	supportedExts map[string]ConfigFormat
-	formats       FormatMap
-	strictMode    bool
-	maxFileSize   int64
-	recursive     bool
+	logger         *slog.Logger
+	defaultPath    string
+	supportedExts  map[string]ConfigFormat
+	formats        FormatMap
+	strictMode     bool
+	maxFileSize    int64
+	recursive      bool
+	mergeStrategy  MergeStrategy
+	envExpansion   bool
+	envPrefix      string
+	reloadDebounce time.Duration
+	validator      Validator[T]
+	defaulter      func(*T)
+	source         Source
+	includeGlobs   []string
+	excludeGlobs   []string
 }
 
 // ConfigReaderOption provides functional options for ConfigReader
@@ -89,6 +124,15 @@ func WithRecursive[T any](recursive bool) ConfigReaderOption[T] {
 	}
 }
 
+// WithSource overrides where ConfigReader reads files and directories from.
+// The default is the local filesystem; see the http, s3 and k8s build tags
+// for remote backends.
+func WithSource[T any](source Source) ConfigReaderOption[T] {
+	return func(cr *ConfigReader[T]) {
+		cr.source = source
+	}
+}
+
 // NewConfigReader creates a new ConfigReader with sensible defaults
 func NewConfigReader[T any](opts ...ConfigReaderOption[T]) *ConfigReader[T] {
 	cr := &ConfigReader[T]{
@@ -99,6 +143,7 @@ func NewConfigReader[T any](opts ...ConfigReaderOption[T]) *ConfigReader[T] {
 		recursive:     false,
 		supportedExts: make(map[string]ConfigFormat),
 		formats:       make(FormatMap),
+		source:        localSource{},
 	}
 	cr.RegisterFormats(DefaultFormats)
 
@@ -129,13 +174,13 @@ func (cr *ConfigReader[T]) detectFormat(filename string) ConfigFormat {
 	return FormatUnknown
 }
 
-// readAndParseFile reads a file and unmarshals it into the target structure
-func (cr *ConfigReader[T]) readAndParseFile(fullPath string, format ConfigFormat, target *T) error {
+// readAndParseFile reads r and unmarshals it into the target structure
+func (cr *ConfigReader[T]) readAndParseFile(fullPath string, r io.Reader, format ConfigFormat, target *T) error {
 	cr.logger.Debug("reading configuration file",
 		slog.String("path", fullPath),
 		slog.String("format", cr.formats[format].Name))
 
-	data, err := os.ReadFile(fullPath)
+	data, err := io.ReadAll(r)
 	if err != nil {
 		return fmt.Errorf("failed to read file: %w", err)
 	}
@@ -149,10 +194,39 @@ func (cr *ConfigReader[T]) readAndParseFile(fullPath string, format ConfigFormat
 		return fmt.Errorf("no registered format: %s", formatItem.Name)
 	}
 
+	if formatItem.Unmarshal == nil {
+		return fmt.Errorf("format %q has no decoder registered; build with the matching build tag or call RegisterFormats with a custom Unmarshal", formatItem.Name)
+	}
+
+	var missing []string
+	if cr.envExpansion {
+		var m []string
+		data, m = cr.expandEnvBytes(data)
+		missing = append(missing, m...)
+	}
+
 	if err := formatItem.Unmarshal(data, target); err != nil {
 		return fmt.Errorf("failed to unmarshal %s: %w", formatItem.Name, err)
 	}
 
+	if cr.envExpansion {
+		missing = append(missing, cr.expandEnvReflect(target)...)
+	}
+
+	if len(missing) > 0 && cr.strictMode {
+		return fmt.Errorf("failed to expand environment references in %s: %w", fullPath, &EnvExpansionError{Missing: missing})
+	}
+
+	if cr.defaulter != nil {
+		cr.defaulter(target)
+	}
+
+	if cr.validator != nil {
+		if err := cr.validator.Validate(target); err != nil {
+			return &ValidationError{Err: err}
+		}
+	}
+
 	cr.logger.Debug("file parsed successfully",
 		slog.String("path", fullPath),
 		slog.String("format", formatItem.Name))
@@ -160,8 +234,15 @@ func (cr *ConfigReader[T]) readAndParseFile(fullPath string, format ConfigFormat
 	return nil
 }
 
-// processFile handles the complete lifecycle of reading and parsing a single file
-func (cr *ConfigReader[T]) processFile(fullPath string, info fs.FileInfo) (*T, string, error) {
+// processFile handles the complete lifecycle of opening, reading and
+// parsing a single file through the reader's configured Source.
+func (cr *ConfigReader[T]) processFile(fullPath string) (*T, string, error) {
+	rc, info, err := cr.source.Open(fullPath)
+	if err != nil {
+		return nil, baseName(filepath.Base(fullPath)), fmt.Errorf("failed to open file: %w", err)
+	}
+	defer rc.Close()
+
 	filename := info.Name()
 
 	if info.IsDir() {
@@ -172,20 +253,21 @@ func (cr *ConfigReader[T]) processFile(fullPath string, info fs.FileInfo) (*T, s
 		return nil, baseName(filename), fmt.Errorf("file size %d exceeds maximum allowed size %d", info.Size(), cr.maxFileSize)
 	}
 
-	format := cr.detectFormat(info.Name())
+	format := cr.detectFormat(filename)
 	if format == FormatUnknown {
 		return nil, baseName(filename), fmt.Errorf("unsupported file format")
 	}
 
 	var cfg T
-	if err := cr.readAndParseFile(fullPath, format, &cfg); err != nil {
-		return nil, "", err
+	if err := cr.readAndParseFile(fullPath, rc, format, &cfg); err != nil {
+		return nil, baseName(filename), err
 	}
 
 	return &cfg, baseName(filename), nil
 }
 
-// ReadFile reads and parses a single configuration file
+// ReadFile reads and parses a single configuration file through the
+// reader's Source (the local filesystem, unless WithSource was used).
 func (cr *ConfigReader[T]) ReadFile(filePath string) (*T, error) {
 	if filePath == "" {
 		filePath = cr.defaultPath
@@ -196,16 +278,7 @@ func (cr *ConfigReader[T]) ReadFile(filePath string) (*T, error) {
 	cr.logger.Info("reading configuration file",
 		slog.String("path", filePath))
 
-	info, err := os.Stat(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("configuration file inaccessible: %w", err)
-	}
-
-	if info.IsDir() {
-		return nil, fmt.Errorf("path is a directory, use ReadDir or ReadDirMap instead")
-	}
-
-	cfg, _, err := cr.processFile(filePath, info)
+	cfg, _, err := cr.processFile(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to process file: %w", err)
 	}
@@ -245,23 +318,15 @@ func (cr *ConfigReader[T]) ReadDirMap(dirPath string) (map[string]*T, error) {
 		slog.Bool("strict_mode", cr.strictMode),
 		slog.Bool("recursive", cr.recursive))
 
-	// Verify directory exists and is accessible
-	info, err := os.Stat(dirPath)
-	if err != nil {
-		return nil, fmt.Errorf("configuration directory inaccessible: %w", err)
-	}
-
-	if !info.IsDir() {
-		return nil, fmt.Errorf("path is not a directory, use ReadFile instead")
-	}
-
 	configs := make(map[string]*T)
 	stats := &scanStats{}
+	cfgIgnore := cr.loadCfgIgnore(dirPath)
 
+	var err error
 	if cr.recursive {
-		err = cr.scanRecursive(dirPath, configs, stats)
+		err = cr.scanRecursive(dirPath, dirPath, cfgIgnore, configs, stats)
 	} else {
-		err = cr.scanFlat(dirPath, configs, stats)
+		err = cr.scanFlat(dirPath, dirPath, cfgIgnore, configs, stats)
 	}
 
 	if err != nil {
@@ -279,34 +344,39 @@ func (cr *ConfigReader[T]) ReadDirMap(dirPath string) (map[string]*T, error) {
 			slog.String("dir", dirPath))
 	}
 
+	if len(stats.validationErrors) > 0 {
+		return configs, &DirValidationError{Errors: stats.validationErrors}
+	}
+
 	return configs, nil
 }
 
 // scanStats tracks statistics during directory scanning
 type scanStats struct {
-	processed int
-	skipped   int
-	errors    int
+	processed        int
+	skipped          int
+	errors           int
+	validationErrors map[string]error
 }
 
 // scanFlat scans a single directory level (non-recursive)
-func (cr *ConfigReader[T]) scanFlat(dirPath string, configs map[string]*T, stats *scanStats) error {
-	files, err := os.ReadDir(dirPath)
+func (cr *ConfigReader[T]) scanFlat(dirPath, scanRoot string, cfgIgnore []string, configs map[string]*T, stats *scanStats) error {
+	entries, err := cr.source.List(dirPath)
 	if err != nil {
 		return fmt.Errorf("failed to read directory: %w", err)
 	}
 
 	cr.logger.Info("directory scan complete",
 		slog.String("dir", dirPath),
-		slog.Int("total_entries", len(files)))
+		slog.Int("total_entries", len(entries)))
 
-	for _, file := range files {
-		if file.IsDir() {
+	for _, entry := range entries {
+		if entry.IsDir() {
 			stats.skipped++
 			continue
 		}
 
-		if err := cr.processEntry(dirPath, file, configs, stats); err != nil {
+		if err := cr.processEntry(dirPath, scanRoot, cfgIgnore, entry, configs, stats); err != nil {
 			if cr.strictMode {
 				return err
 			}
@@ -316,54 +386,71 @@ func (cr *ConfigReader[T]) scanFlat(dirPath string, configs map[string]*T, stats
 	return nil
 }
 
-// scanRecursive scans directories recursively
-func (cr *ConfigReader[T]) scanRecursive(dirPath string, configs map[string]*T, stats *scanStats) error {
-	return filepath.WalkDir(dirPath, func(path string, d fs.DirEntry, err error) error {
-		if err != nil {
-			cr.logger.Warn("error accessing path during recursive scan",
-				slog.String("path", path),
-				slog.String("error", err.Error()))
-			if cr.strictMode {
-				return fmt.Errorf("strict mode: failed to access %s: %w", path, err)
+// scanRecursive scans directories recursively via the Source's List method.
+func (cr *ConfigReader[T]) scanRecursive(dirPath, scanRoot string, cfgIgnore []string, configs map[string]*T, stats *scanStats) error {
+	entries, err := cr.source.List(dirPath)
+	if err != nil {
+		return fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		fullPath := cr.source.Join(dirPath, entry.Name())
+
+		if entry.IsDir() {
+			if !cr.shouldDescend(relativeScanPath(scanRoot, fullPath)+"/", cfgIgnore) {
+				stats.skipped++
+				continue
 			}
-			stats.errors++
-			return nil // Continue walking
+
+			if err := cr.scanRecursive(fullPath, scanRoot, cfgIgnore, configs, stats); err != nil {
+				if cr.strictMode {
+					return err
+				}
+				stats.errors++
+			}
+			continue
 		}
 
-		if d.IsDir() {
-			return nil // Continue into subdirectories
+		if err := cr.processEntry(dirPath, scanRoot, cfgIgnore, entry, configs, stats); err != nil {
+			if cr.strictMode {
+				return err
+			}
 		}
+	}
 
-		parentDir := filepath.Dir(path)
-		return cr.processEntry(parentDir, d, configs, stats)
-	})
+	return nil
 }
 
 // processEntry handles a single directory entry
-func (cr *ConfigReader[T]) processEntry(parentDir string, entry fs.DirEntry, configs map[string]*T, stats *scanStats) error {
+func (cr *ConfigReader[T]) processEntry(parentDir, scanRoot string, cfgIgnore []string, entry fs.DirEntry, configs map[string]*T, stats *scanStats) error {
 	filename := entry.Name()
-	fullPath := filepath.Join(parentDir, filename)
+	fullPath := cr.source.Join(parentDir, filename)
 
-	info, err := entry.Info()
-	if err != nil {
-		stats.errors++
-		cr.logger.Warn("failed to get file info",
-			slog.String("file", filename),
-			slog.String("error", err.Error()))
-		if cr.strictMode {
-			return fmt.Errorf("strict mode: failed to get info for %s: %w", filename, err)
-		}
+	if !cr.shouldInclude(relativeScanPath(scanRoot, fullPath), cfgIgnore) {
 		stats.skipped++
 		return nil
 	}
 
-	cfg, baseName, err := cr.processFile(fullPath, info)
+	cfg, baseName, err := cr.processFile(fullPath)
 	if err != nil {
 		stats.errors++
 		cr.logger.Warn("failed to process configuration file",
 			slog.String("file", fullPath),
 			slog.String("error", err.Error()))
 
+		var validationErr *ValidationError
+		if errors.As(err, &validationErr) {
+			// Collected and reported together at the end of the scan so
+			// operators see every bad file in one pass instead of the scan
+			// dying on the first one.
+			if stats.validationErrors == nil {
+				stats.validationErrors = make(map[string]error)
+			}
+			stats.validationErrors[baseName] = err
+			stats.skipped++
+			return nil
+		}
+
 		if cr.strictMode {
 			return fmt.Errorf("strict mode: failed to process %s: %w", filename, err)
 		}
@@ -405,10 +492,11 @@ func (cr *ConfigReader[T]) Read(path string) (content any, isDir bool, err error
 		path = cr.defaultPath
 	}
 
-	info, err := os.Stat(path)
+	rc, info, err := cr.source.Open(path)
 	if err != nil {
 		return nil, false, fmt.Errorf("path inaccessible: %w", err)
 	}
+	rc.Close()
 
 	if info.IsDir() {
 		cr.logger.Info("detected directory, using ReadDirMap",
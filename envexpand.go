@@ -0,0 +1,155 @@
+package cfgreader
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// envPattern matches ${NAME}, ${NAME:-default} and ${NAME:?error} references.
+var envPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-[^}]*|:\?[^}]*)?\}`)
+
+// WithEnvExpansion enables expansion of ${NAME}, ${NAME:-default} and
+// ${NAME:?error} references against the process environment, both in the
+// raw file bytes before unmarshalling and in any string field of T left
+// unresolved afterwards. Missing required variables (bare ${NAME} or
+// ${NAME:?error} with no value) expand to an empty string; in strict mode
+// they are also collected and returned as a single *EnvExpansionError.
+func WithEnvExpansion[T any](enabled bool) ConfigReaderOption[T] {
+	return func(cr *ConfigReader[T]) {
+		cr.envExpansion = enabled
+	}
+}
+
+// WithEnvPrefix scopes environment lookups performed during expansion: a
+// reference ${NAME} resolves against the environment variable
+// "<prefix>NAME" instead of "NAME". Useful to namespace several readers
+// against the same process environment.
+func WithEnvPrefix[T any](prefix string) ConfigReaderOption[T] {
+	return func(cr *ConfigReader[T]) {
+		cr.envPrefix = prefix
+	}
+}
+
+// EnvExpansionError reports every ${NAME} / ${NAME:?error} reference that
+// could not be resolved against the environment.
+type EnvExpansionError struct {
+	Missing []string
+}
+
+func (e *EnvExpansionError) Error() string {
+	return fmt.Sprintf("unresolved required environment variable(s): %s", strings.Join(e.Missing, ", "))
+}
+
+// expandEnvBytes replaces every ${NAME}/${NAME:-default}/${NAME:?error}
+// reference found in data with its resolved value. Missing required
+// variables are returned in missing but the reference is left as an empty
+// string in the output so parsing can still proceed in non-strict mode.
+func (cr *ConfigReader[T]) expandEnvBytes(data []byte) ([]byte, []string) {
+	var missing []string
+
+	out := envPattern.ReplaceAllFunc(data, func(match []byte) []byte {
+		value, ok, required := cr.resolveEnvRef(string(match))
+		if !ok && required {
+			missing = append(missing, envVarName(string(match)))
+		}
+		return []byte(value)
+	})
+
+	return out, missing
+}
+
+// expandEnvReflect walks v (expected to be a pointer to struct, as passed to
+// Unmarshal) and expands any remaining ${...} references left in string
+// fields, recursing into nested structs, pointers, slices, arrays and maps.
+func (cr *ConfigReader[T]) expandEnvReflect(v any) []string {
+	var missing []string
+	cr.expandEnvValue(reflect.ValueOf(v), &missing)
+	return missing
+}
+
+func (cr *ConfigReader[T]) expandEnvValue(val reflect.Value, missing *[]string) {
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !val.IsNil() {
+			cr.expandEnvValue(val.Elem(), missing)
+		}
+	case reflect.Struct:
+		for i := 0; i < val.NumField(); i++ {
+			field := val.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+			cr.expandEnvValue(field, missing)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			cr.expandEnvValue(val.Index(i), missing)
+		}
+	case reflect.Map:
+		elemType := val.Type().Elem()
+		for _, key := range val.MapKeys() {
+			// Map values aren't addressable/settable in place, so recurse
+			// into an addressable copy and write the whole value back with
+			// SetMapIndex. This covers string values as well as nested
+			// structs, pointers and maps (e.g. map[string]ServiceConfig).
+			elemCopy := reflect.New(elemType).Elem()
+			elemCopy.Set(val.MapIndex(key))
+			cr.expandEnvValue(elemCopy, missing)
+			val.SetMapIndex(key, elemCopy)
+		}
+	case reflect.String:
+		if val.CanSet() {
+			expanded, m := cr.expandEnvString(val.String())
+			*missing = append(*missing, m...)
+			val.SetString(expanded)
+		}
+	}
+}
+
+func (cr *ConfigReader[T]) expandEnvString(s string) (string, []string) {
+	if !strings.Contains(s, "${") {
+		return s, nil
+	}
+	expanded, missing := cr.expandEnvBytes([]byte(s))
+	return string(expanded), missing
+}
+
+// resolveEnvRef resolves a single ${NAME}, ${NAME:-default} or
+// ${NAME:?error} reference (the raw matched text, braces included). ok
+// reports whether a value was produced; required reports whether the
+// reference demanded one (i.e. had no default).
+func (cr *ConfigReader[T]) resolveEnvRef(ref string) (value string, ok bool, required bool) {
+	name := envVarName(ref)
+	envValue, present := os.LookupEnv(cr.envPrefix + name)
+
+	switch {
+	case strings.Contains(ref, ":-"):
+		def := ref[strings.Index(ref, ":-")+2 : len(ref)-1]
+		if present {
+			return envValue, true, false
+		}
+		return def, true, false
+	case strings.Contains(ref, ":?"):
+		if present {
+			return envValue, true, true
+		}
+		return "", false, true
+	default:
+		if present {
+			return envValue, true, true
+		}
+		return "", false, true
+	}
+}
+
+// envVarName extracts NAME out of a matched ${NAME...} reference.
+func envVarName(ref string) string {
+	inner := strings.TrimSuffix(strings.TrimPrefix(ref, "${"), "}")
+	if idx := strings.IndexAny(inner, ":"); idx != -1 {
+		return inner[:idx]
+	}
+	return inner
+}
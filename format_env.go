@@ -0,0 +1,101 @@
+//go:build dotenv
+
+package cfgreader
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+func init() {
+	registerBuiltinUnmarshal(FormatDotEnv, unmarshalDotEnv)
+}
+
+// unmarshalDotEnv decodes a dotenv (.env) file into v, a pointer to struct.
+// Dotenv only has a flat string-to-string shape, so each key is matched
+// against a field's `json` tag (falling back to the field name) and the
+// string value is coerced to that field's kind — strconv-style, the way
+// mapstructure's weakly-typed decoding works — rather than round-tripped
+// through encoding/json, which would reject every non-string field.
+func unmarshalDotEnv(data []byte, v any) error {
+	envMap, err := godotenv.Parse(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to parse dotenv: %w", err)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("dotenv target must be a pointer to struct, got %T", v)
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rv.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		key := rt.Field(i).Name
+		if tag, ok := rt.Field(i).Tag.Lookup("json"); ok {
+			name := strings.Split(tag, ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name != "" {
+				key = name
+			}
+		}
+
+		raw, ok := envMap[key]
+		if !ok {
+			continue
+		}
+
+		if err := setFieldFromString(field, raw); err != nil {
+			return fmt.Errorf("failed to assign %s=%q: %w", key, raw, err)
+		}
+	}
+
+	return nil
+}
+
+// setFieldFromString coerces raw to field's kind and sets it.
+func setFieldFromString(field reflect.Value, raw string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field kind %s for dotenv value", field.Kind())
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+//go:build hcl
+
+package cfgreader
+
+import (
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+func init() {
+	registerBuiltinUnmarshal(FormatHCL, unmarshalHCL)
+}
+
+// unmarshalHCL decodes HCL/HCL2 bytes into v. The filename passed to the
+// parser is only used for diagnostic messages, so a fixed placeholder is
+// fine here.
+func unmarshalHCL(data []byte, v any) error {
+	file, diags := hclparse.NewParser().ParseHCL(data, "config.hcl")
+	if diags.HasErrors() {
+		return diags
+	}
+
+	if diags := gohcl.DecodeBody(file.Body, nil, v); diags.HasErrors() {
+		return diags
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+//go:build ini
+
+package cfgreader
+
+import "gopkg.in/ini.v1"
+
+func init() {
+	registerBuiltinUnmarshal(FormatINI, unmarshalINI)
+}
+
+// unmarshalINI decodes INI bytes into v using struct tags understood by
+// go-ini (ini:"section" / ini:"key").
+func unmarshalINI(data []byte, v any) error {
+	f, err := ini.Load(data)
+	if err != nil {
+		return err
+	}
+
+	return f.MapTo(v)
+}
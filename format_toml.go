@@ -0,0 +1,9 @@
+//go:build toml
+
+package cfgreader
+
+import "github.com/pelletier/go-toml/v2"
+
+func init() {
+	registerBuiltinUnmarshal(FormatTOML, toml.Unmarshal)
+}
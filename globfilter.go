@@ -0,0 +1,139 @@
+package cfgreader
+
+import (
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// cfgIgnoreFile is discovered at the root of every ReadDirMap/ReadDir scan
+// and, if present, its patterns are applied the same way .gitignore would
+// be: one doublestar pattern per line, blank lines and "#" comments
+// ignored, a leading "!" re-includes a path an earlier line excluded.
+const cfgIgnoreFile = ".cfgignore"
+
+// WithIncludeGlobs restricts directory scans to entries whose path
+// (relative to the scan root, always slash-separated) matches at least one
+// of patterns. A pattern prefixed with "!" re-excludes a path an earlier
+// match in the list would otherwise include; patterns are evaluated in
+// order and the last match wins, same as .gitignore.
+func WithIncludeGlobs[T any](patterns []string) ConfigReaderOption[T] {
+	return func(cr *ConfigReader[T]) {
+		cr.includeGlobs = patterns
+	}
+}
+
+// WithExcludeGlobs drops directory scan entries whose relative path matches
+// any of patterns, regardless of WithIncludeGlobs.
+func WithExcludeGlobs[T any](patterns []string) ConfigReaderOption[T] {
+	return func(cr *ConfigReader[T]) {
+		cr.excludeGlobs = patterns
+	}
+}
+
+// loadCfgIgnore reads .cfgignore from the scan root, if present, returning
+// its patterns. A missing file is not an error.
+func (cr *ConfigReader[T]) loadCfgIgnore(root string) []string {
+	rc, _, err := cr.source.Open(cr.source.Join(root, cfgIgnoreFile))
+	if err != nil {
+		return nil
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil
+	}
+
+	var patterns []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+
+	return patterns
+}
+
+// shouldInclude reports whether relPath (slash-separated, relative to the
+// scan root) should be processed given the reader's include/exclude globs
+// and the scan root's .cfgignore patterns. Use this for leaf files only;
+// for directories (deciding whether to recurse into them), use
+// shouldDescend instead, since an include pattern like "**/*.yaml" is
+// never meant to match a bare directory path.
+func (cr *ConfigReader[T]) shouldInclude(relPath string, cfgIgnore []string) bool {
+	if !cr.shouldDescend(relPath, cfgIgnore) {
+		return false
+	}
+
+	if len(cr.includeGlobs) == 0 {
+		return true
+	}
+
+	included := false
+	for _, pattern := range cr.includeGlobs {
+		negate := strings.HasPrefix(pattern, "!")
+		ok, _ := doublestar.Match(strings.TrimPrefix(pattern, "!"), relPath)
+		if !ok {
+			continue
+		}
+		included = !negate
+	}
+
+	return included
+}
+
+// shouldDescend reports whether relPath (a file or directory path,
+// slash-separated and relative to the scan root) is allowed by the
+// reader's exclude globs and the scan root's .cfgignore patterns alone.
+// WithIncludeGlobs is deliberately not consulted here: it selects which
+// leaf files to load, not which directories a recursive scan may enter.
+func (cr *ConfigReader[T]) shouldDescend(relPath string, cfgIgnore []string) bool {
+	if matchesIgnore(cfgIgnore, relPath) {
+		return false
+	}
+
+	if matchesAny(cr.excludeGlobs, relPath) {
+		return false
+	}
+
+	return true
+}
+
+// matchesAny reports whether relPath matches any pattern in patterns.
+func matchesAny(patterns []string, relPath string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := doublestar.Match(pattern, relPath); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesIgnore applies .cfgignore-style patterns: later lines override
+// earlier ones, and a leading "!" negates an exclusion.
+func matchesIgnore(patterns []string, relPath string) bool {
+	ignored := false
+	for _, pattern := range patterns {
+		negate := strings.HasPrefix(pattern, "!")
+		ok, _ := doublestar.Match(strings.TrimPrefix(pattern, "!"), relPath)
+		if !ok {
+			continue
+		}
+		ignored = !negate
+	}
+	return ignored
+}
+
+// relativeScanPath returns full's path relative to root, always
+// slash-separated, for matching against globs and .cfgignore patterns.
+func relativeScanPath(root, full string) string {
+	rel := strings.TrimPrefix(full, root)
+	rel = strings.TrimPrefix(rel, "/")
+	rel = strings.TrimPrefix(rel, string(filepath.Separator))
+	return filepath.ToSlash(rel)
+}
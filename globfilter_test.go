@@ -0,0 +1,143 @@
+package cfgreader
+
+import "testing"
+
+type globTestCfg struct{}
+
+func newGlobTestReader(include, exclude []string) *ConfigReader[globTestCfg] {
+	return NewConfigReader[globTestCfg](
+		WithIncludeGlobs[globTestCfg](include),
+		WithExcludeGlobs[globTestCfg](exclude),
+	)
+}
+
+func TestShouldInclude(t *testing.T) {
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		cfgIgn  []string
+		relPath string
+		want    bool
+	}{
+		{
+			name:    "no globs includes everything",
+			relPath: "svc.yaml",
+			want:    true,
+		},
+		{
+			name:    "matches include pattern",
+			include: []string{"**/*.yaml"},
+			relPath: "sub/svc.yaml",
+			want:    true,
+		},
+		{
+			name:    "does not match include pattern",
+			include: []string{"**/*.yaml"},
+			relPath: "sub/svc.json",
+			want:    false,
+		},
+		{
+			name:    "exclude wins over include",
+			include: []string{"**/*.yaml"},
+			exclude: []string{"secret/**"},
+			relPath: "secret/svc.yaml",
+			want:    false,
+		},
+		{
+			name:    "cfgignore excludes",
+			cfgIgn:  []string{"local.yaml"},
+			relPath: "local.yaml",
+			want:    false,
+		},
+		{
+			name:    "last-match-wins: a later pattern re-includes what an earlier one excluded",
+			include: []string{"*.yaml", "!dev.yaml", "dev.yaml"},
+			relPath: "dev.yaml",
+			want:    true,
+		},
+		{
+			name:    "negated include pattern excludes a path the earlier pattern matched",
+			include: []string{"**/*.yaml", "!dev.yaml"},
+			relPath: "dev.yaml",
+			want:    false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cr := newGlobTestReader(tc.include, tc.exclude)
+			got := cr.shouldInclude(tc.relPath, tc.cfgIgn)
+			if got != tc.want {
+				t.Errorf("shouldInclude(%q) = %v, want %v", tc.relPath, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestShouldDescendIgnoresIncludeGlobs(t *testing.T) {
+	// A directory path should never be pruned by includeGlobs: an include
+	// pattern like "**/*.yaml" is meant to select leaf files, not gate
+	// which directories a recursive scan may enter.
+	cr := newGlobTestReader([]string{"**/*.yaml"}, nil)
+
+	if !cr.shouldDescend("subdir/", nil) {
+		t.Error("shouldDescend pruned a directory based on includeGlobs")
+	}
+}
+
+func TestShouldDescendAppliesExcludeGlobsAndCfgIgnore(t *testing.T) {
+	cr := newGlobTestReader(nil, []string{"vendor/**"})
+
+	if cr.shouldDescend("vendor/", nil) {
+		t.Error("shouldDescend did not prune an excluded directory")
+	}
+	if !cr.shouldDescend("subdir/", nil) {
+		t.Error("shouldDescend pruned an unrelated directory")
+	}
+	if cr.shouldDescend("tmp/", []string{"tmp/"}) {
+		t.Error("shouldDescend did not honor .cfgignore")
+	}
+}
+
+func TestMatchesIgnoreLastMatchWins(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		relPath  string
+		want     bool
+	}{
+		{
+			name:     "simple match",
+			patterns: []string{"*.log"},
+			relPath:  "debug.log",
+			want:     true,
+		},
+		{
+			name:     "negation re-includes",
+			patterns: []string{"*.log", "!debug.log"},
+			relPath:  "debug.log",
+			want:     false,
+		},
+		{
+			name:     "later exclude overrides earlier negation",
+			patterns: []string{"*.log", "!debug.log", "debug.log"},
+			relPath:  "debug.log",
+			want:     true,
+		},
+		{
+			name:     "no match",
+			patterns: []string{"*.log"},
+			relPath:  "config.yaml",
+			want:     false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := matchesIgnore(tc.patterns, tc.relPath); got != tc.want {
+				t.Errorf("matchesIgnore(%v, %q) = %v, want %v", tc.patterns, tc.relPath, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package cfgreader
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MergeStrategy controls how slice values are combined when merging two
+// decoded documents together in ReadLayered.
+type MergeStrategy int
+
+const (
+	// MergeReplaceScalars replaces scalars and slices wholesale with the
+	// value from the later source. This is the default.
+	MergeReplaceScalars MergeStrategy = iota
+	// MergeAppendSlices concatenates slice values instead of replacing them.
+	MergeAppendSlices
+)
+
+// overrideSuffix and deleteSuffix are JSON-Patch-flavoured tags a map key can
+// carry to control how it merges, independent of the configured
+// MergeStrategy: "key!override" always replaces rather than merging, and
+// "key!delete" removes the key entirely.
+const (
+	overrideSuffix = "!override"
+	deleteSuffix   = "!delete"
+)
+
+// WithMergeStrategy sets how ReadLayered combines slice values found in more
+// than one source. Map keys merge recursively regardless of this setting;
+// scalars always replace.
+func WithMergeStrategy[T any](strategy MergeStrategy) ConfigReaderOption[T] {
+	return func(cr *ConfigReader[T]) {
+		cr.mergeStrategy = strategy
+	}
+}
+
+// ReadLayered reads every path in order and deep-merges the results into a
+// single *T, with later paths overriding earlier ones. This mirrors the
+// systemd/nginx "base file plus conf.d drop-ins" pattern: pass the base file
+// first and the override files afterwards.
+func (cr *ConfigReader[T]) ReadLayered(paths ...string) (*T, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("ReadLayered: no paths given")
+	}
+
+	merged := map[string]any{}
+	var missing []string
+
+	for _, path := range paths {
+		layer, layerMissing, err := cr.readLayer(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read layer %q: %w", path, err)
+		}
+
+		missing = append(missing, layerMissing...)
+		merged = mergeMaps(merged, layer, cr.mergeStrategy)
+	}
+
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-encode merged configuration: %w", err)
+	}
+
+	var result T
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode merged configuration into target type: %w", err)
+	}
+
+	if cr.envExpansion {
+		missing = append(missing, cr.expandEnvReflect(&result)...)
+	}
+
+	if len(missing) > 0 && cr.strictMode {
+		return nil, fmt.Errorf("failed to expand environment references in layered configuration: %w", &EnvExpansionError{Missing: missing})
+	}
+
+	if cr.defaulter != nil {
+		cr.defaulter(&result)
+	}
+
+	if cr.validator != nil {
+		if err := cr.validator.Validate(&result); err != nil {
+			return nil, &ValidationError{Err: err}
+		}
+	}
+
+	return &result, nil
+}
+
+// readLayer opens a single layer file and decodes it into a raw
+// map[string]any, applying byte-level environment expansion first if
+// enabled. It is a thin, non-generic sibling of readAndParseFile: a layer's
+// shape is not yet T, so it cannot go through the generic decode path. The
+// returned slice holds any required "${VAR}" references left unresolved by
+// byte-level expansion, mirroring readAndParseFile so ReadLayered can fail
+// in strict mode exactly like ReadFile does.
+func (cr *ConfigReader[T]) readLayer(path string) (map[string]any, []string, error) {
+	rc, info, err := cr.source.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer rc.Close()
+
+	if info.Size() > cr.maxFileSize {
+		return nil, nil, fmt.Errorf("file size %d exceeds maximum allowed size %d", info.Size(), cr.maxFileSize)
+	}
+
+	format := cr.detectFormat(info.Name())
+	if format == FormatUnknown {
+		return nil, nil, fmt.Errorf("unsupported file format")
+	}
+
+	formatItem, exists := cr.formats[format]
+	if !exists {
+		return nil, nil, fmt.Errorf("no registered format: %s", formatItem.Name)
+	}
+
+	if formatItem.Unmarshal == nil {
+		return nil, nil, fmt.Errorf("format %q has no decoder registered; build with the matching build tag or call RegisterFormats with a custom Unmarshal", formatItem.Name)
+	}
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var missing []string
+	if cr.envExpansion {
+		data, missing = cr.expandEnvBytes(data)
+	}
+
+	var layer map[string]any
+	if err := formatItem.Unmarshal(data, &layer); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal %s: %w", formatItem.Name, err)
+	}
+
+	return layer, missing, nil
+}
+
+// mergeMaps recursively merges src into dst (without mutating either) and
+// returns the result. Maps merge key-by-key, slices combine according to
+// strategy, and scalars from src replace whatever dst had.
+func mergeMaps(dst, src map[string]any, strategy MergeStrategy) map[string]any {
+	result := make(map[string]any, len(dst))
+	for k, v := range dst {
+		result[k] = v
+	}
+
+	for key, srcVal := range src {
+		switch {
+		case strings.HasSuffix(key, deleteSuffix):
+			delete(result, strings.TrimSuffix(key, deleteSuffix))
+		case strings.HasSuffix(key, overrideSuffix):
+			result[strings.TrimSuffix(key, overrideSuffix)] = srcVal
+		default:
+			result[key] = mergeValue(result[key], srcVal, strategy)
+		}
+	}
+
+	return result
+}
+
+// mergeValue merges a single key's previous and new value according to
+// strategy, recursing into nested maps.
+func mergeValue(dstVal, srcVal any, strategy MergeStrategy) any {
+	dstMap, dstIsMap := dstVal.(map[string]any)
+	srcMap, srcIsMap := srcVal.(map[string]any)
+	if dstIsMap && srcIsMap {
+		return mergeMaps(dstMap, srcMap, strategy)
+	}
+
+	dstSlice, dstIsSlice := dstVal.([]any)
+	srcSlice, srcIsSlice := srcVal.([]any)
+	if strategy == MergeAppendSlices && dstIsSlice && srcIsSlice {
+		return append(append([]any{}, dstSlice...), srcSlice...)
+	}
+
+	return srcVal
+}
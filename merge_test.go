@@ -0,0 +1,84 @@
+package cfgreader
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeMaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		dst      map[string]any
+		src      map[string]any
+		strategy MergeStrategy
+		want     map[string]any
+	}{
+		{
+			name: "scalar replaces scalar",
+			dst:  map[string]any{"port": float64(8080)},
+			src:  map[string]any{"port": float64(9090)},
+			want: map[string]any{"port": float64(9090)},
+		},
+		{
+			name: "maps merge key by key",
+			dst:  map[string]any{"db": map[string]any{"host": "a", "port": float64(1)}},
+			src:  map[string]any{"db": map[string]any{"port": float64(2)}},
+			want: map[string]any{"db": map[string]any{"host": "a", "port": float64(2)}},
+		},
+		{
+			name:     "slices replace by default",
+			dst:      map[string]any{"tags": []any{"a", "b"}},
+			src:      map[string]any{"tags": []any{"c"}},
+			strategy: MergeReplaceScalars,
+			want:     map[string]any{"tags": []any{"c"}},
+		},
+		{
+			name:     "slices append under MergeAppendSlices",
+			dst:      map[string]any{"tags": []any{"a", "b"}},
+			src:      map[string]any{"tags": []any{"c"}},
+			strategy: MergeAppendSlices,
+			want:     map[string]any{"tags": []any{"a", "b", "c"}},
+		},
+		{
+			name: "!override replaces instead of merging nested maps",
+			dst:  map[string]any{"db": map[string]any{"host": "a", "port": float64(1)}},
+			src:  map[string]any{"db!override": map[string]any{"host": "b"}},
+			want: map[string]any{"db": map[string]any{"host": "b"}},
+		},
+		{
+			name: "!delete removes the key",
+			dst:  map[string]any{"db": map[string]any{"host": "a"}, "cache": "redis"},
+			src:  map[string]any{"cache!delete": nil},
+			want: map[string]any{"db": map[string]any{"host": "a"}},
+		},
+		{
+			name: "src key not present in dst is added",
+			dst:  map[string]any{"host": "a"},
+			src:  map[string]any{"port": float64(9090)},
+			want: map[string]any{"host": "a", "port": float64(9090)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mergeMaps(tc.dst, tc.src, tc.strategy)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("mergeMaps(%v, %v, %v) = %v, want %v", tc.dst, tc.src, tc.strategy, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMergeMapsDoesNotMutateInputs(t *testing.T) {
+	dst := map[string]any{"host": "a"}
+	src := map[string]any{"host": "b"}
+
+	mergeMaps(dst, src, MergeReplaceScalars)
+
+	if dst["host"] != "a" {
+		t.Errorf("mergeMaps mutated dst: %v", dst)
+	}
+	if src["host"] != "b" {
+		t.Errorf("mergeMaps mutated src: %v", src)
+	}
+}
@@ -0,0 +1,23 @@
+package cfgreader
+
+import (
+	"io"
+	"io/fs"
+)
+
+// Source abstracts where configuration bytes live, so ConfigReader can load
+// from the local filesystem, HTTP(S), S3, a Kubernetes ConfigMap, or any
+// other backend without changing the rest of its API. Paths are
+// source-specific: the local Source takes filesystem paths, while the
+// http/s3/k8s sources take URLs in their own scheme.
+type Source interface {
+	// Open returns a readable stream for path plus its FileInfo. The
+	// caller is responsible for closing the returned ReadCloser.
+	Open(path string) (io.ReadCloser, fs.FileInfo, error)
+	// List returns the entries directly under path.
+	List(path string) ([]fs.DirEntry, error)
+	// Join combines a directory and an entry name the way this Source's
+	// paths are built (filepath.Join for local paths, a single "/" between
+	// the trimmed directory and name for remote URLs).
+	Join(dir, name string) string
+}
@@ -0,0 +1,121 @@
+//go:build k8s
+
+package cfgreader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// k8sSource loads configuration from k8s://namespace/configmap[/key] paths,
+// reading ConfigMap data through client-go.
+type k8sSource struct {
+	client kubernetes.Interface
+	ctx    context.Context
+}
+
+// NewK8sSource builds a Source backed by the Kubernetes API via an
+// already-constructed client-go clientset.
+func NewK8sSource(ctx context.Context, client kubernetes.Interface) Source {
+	return &k8sSource{client: client, ctx: ctx}
+}
+
+// splitK8sPath parses k8s://namespace/configmap[/key]. key is empty when
+// the whole ConfigMap is addressed, in which case Open requires the
+// ConfigMap to have exactly one data key.
+func splitK8sPath(p string) (namespace, name, key string, err error) {
+	trimmed := strings.TrimPrefix(p, "k8s://")
+	parts := strings.SplitN(trimmed, "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("invalid k8s path %q, expected k8s://namespace/configmap[/key]", p)
+	}
+	if len(parts) == 3 {
+		key = parts[2]
+	}
+	return parts[0], parts[1], key, nil
+}
+
+func (s *k8sSource) Open(p string) (io.ReadCloser, fs.FileInfo, error) {
+	namespace, name, key, err := splitK8sPath(p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cm, err := s.client.CoreV1().ConfigMaps(namespace).Get(s.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get configmap %s/%s: %w", namespace, name, err)
+	}
+
+	if key == "" {
+		if len(cm.Data) != 1 {
+			return nil, nil, fmt.Errorf("configmap %s/%s has %d keys, address one explicitly as k8s://%s/%s/<key>", namespace, name, len(cm.Data), namespace, name)
+		}
+		for k := range cm.Data {
+			key = k
+		}
+	}
+
+	data, ok := cm.Data[key]
+	if !ok {
+		return nil, nil, fmt.Errorf("configmap %s/%s has no key %q", namespace, name, key)
+	}
+
+	return io.NopCloser(strings.NewReader(data)), k8sFileInfo{name: key, size: int64(len(data))}, nil
+}
+
+func (s *k8sSource) List(p string) ([]fs.DirEntry, error) {
+	namespace, name, _, err := splitK8sPath(p)
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err := s.client.CoreV1().ConfigMaps(namespace).Get(s.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configmap %s/%s: %w", namespace, name, err)
+	}
+
+	entries := make([]fs.DirEntry, 0, len(cm.Data))
+	for k, v := range cm.Data {
+		entries = append(entries, k8sDirEntry{name: k, size: int64(len(v))})
+	}
+
+	return entries, nil
+}
+
+func (s *k8sSource) Join(dir, name string) string {
+	return strings.TrimSuffix(dir, "/") + "/" + name
+}
+
+// k8sFileInfo is a minimal fs.FileInfo for a ConfigMap data key.
+type k8sFileInfo struct {
+	name string
+	size int64
+}
+
+func (i k8sFileInfo) Name() string       { return i.name }
+func (i k8sFileInfo) Size() int64        { return i.size }
+func (i k8sFileInfo) Mode() fs.FileMode  { return 0 }
+func (i k8sFileInfo) ModTime() time.Time { return time.Time{} }
+func (i k8sFileInfo) IsDir() bool        { return false }
+func (i k8sFileInfo) Sys() any           { return nil }
+
+// k8sDirEntry adapts a ConfigMap data key to fs.DirEntry.
+type k8sDirEntry struct {
+	name string
+	size int64
+}
+
+func (e k8sDirEntry) Name() string      { return e.name }
+func (e k8sDirEntry) IsDir() bool       { return false }
+func (e k8sDirEntry) Type() fs.FileMode { return 0 }
+
+func (e k8sDirEntry) Info() (fs.FileInfo, error) {
+	return k8sFileInfo{name: e.name, size: e.size}, nil
+}
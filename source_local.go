@@ -0,0 +1,34 @@
+package cfgreader
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// localSource is the default Source: the local filesystem via os.
+type localSource struct{}
+
+func (localSource) Open(path string) (io.ReadCloser, fs.FileInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+
+	return f, info, nil
+}
+
+func (localSource) List(path string) ([]fs.DirEntry, error) {
+	return os.ReadDir(path)
+}
+
+func (localSource) Join(dir, name string) string {
+	return filepath.Join(dir, name)
+}
@@ -0,0 +1,144 @@
+//go:build s3
+
+package cfgreader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// s3Source loads configuration from s3://bucket/key paths.
+type s3Source struct {
+	client *s3.Client
+	ctx    context.Context
+}
+
+// NewS3Source builds a Source backed by an S3 bucket. If client is nil,
+// credentials and region are resolved the standard AWS SDK way
+// (environment, shared config, instance role, ...).
+func NewS3Source(ctx context.Context, client *s3.Client) (Source, error) {
+	if client != nil {
+		return &s3Source{client: client, ctx: ctx}, nil
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	return &s3Source{client: s3.NewFromConfig(cfg), ctx: ctx}, nil
+}
+
+func splitS3Path(p string) (bucket, key string, err error) {
+	trimmed := strings.TrimPrefix(p, "s3://")
+	parts := strings.SplitN(trimmed, "/", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", fmt.Errorf("invalid s3 path %q, expected s3://bucket/key", p)
+	}
+	return parts[0], parts[1], nil
+}
+
+func (s *s3Source) Open(p string) (io.ReadCloser, fs.FileInfo, error) {
+	bucket, key, err := splitS3Path(p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out, err := s.client.GetObject(s.ctx, &s3.GetObjectInput{Bucket: aws.String(bucket), Key: aws.String(key)})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get s3://%s/%s: %w", bucket, key, err)
+	}
+
+	return out.Body, s3FileInfo{name: key, size: aws.ToInt64(out.ContentLength)}, nil
+}
+
+func (s *s3Source) List(p string) ([]fs.DirEntry, error) {
+	bucket, prefix, err := splitS3Path(p)
+	if err != nil {
+		return nil, err
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	var entries []fs.DirEntry
+	var continuationToken *string
+
+	for {
+		out, err := s.client.ListObjectsV2(s.ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(bucket),
+			Prefix:            aws.String(prefix),
+			Delimiter:         aws.String("/"),
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list s3://%s/%s: %w", bucket, prefix, err)
+		}
+
+		for _, cp := range out.CommonPrefixes {
+			name := strings.TrimSuffix(strings.TrimPrefix(aws.ToString(cp.Prefix), prefix), "/")
+			entries = append(entries, s3DirEntry{name: name, isDir: true})
+		}
+		for _, obj := range out.Contents {
+			name := strings.TrimPrefix(aws.ToString(obj.Key), prefix)
+			if name == "" {
+				continue
+			}
+			entries = append(entries, s3DirEntry{name: name, size: aws.ToInt64(obj.Size)})
+		}
+
+		if !aws.ToBool(out.IsTruncated) {
+			break
+		}
+		continuationToken = out.NextContinuationToken
+	}
+
+	return entries, nil
+}
+
+func (s *s3Source) Join(dir, name string) string {
+	return strings.TrimSuffix(dir, "/") + "/" + name
+}
+
+// s3FileInfo is a minimal fs.FileInfo for a fetched S3 object.
+type s3FileInfo struct {
+	name string
+	size int64
+}
+
+func (i s3FileInfo) Name() string       { return path.Base(i.name) }
+func (i s3FileInfo) Size() int64        { return i.size }
+func (i s3FileInfo) Mode() fs.FileMode  { return 0 }
+func (i s3FileInfo) ModTime() time.Time { return time.Time{} }
+func (i s3FileInfo) IsDir() bool        { return false }
+func (i s3FileInfo) Sys() any           { return nil }
+
+// s3DirEntry adapts an S3 object/common-prefix to fs.DirEntry.
+type s3DirEntry struct {
+	name  string
+	size  int64
+	isDir bool
+}
+
+func (e s3DirEntry) Name() string { return e.name }
+func (e s3DirEntry) IsDir() bool  { return e.isDir }
+
+func (e s3DirEntry) Type() fs.FileMode {
+	if e.isDir {
+		return fs.ModeDir
+	}
+	return 0
+}
+
+func (e s3DirEntry) Info() (fs.FileInfo, error) {
+	return s3FileInfo{name: e.name, size: e.size}, nil
+}
@@ -0,0 +1,75 @@
+package cfgreader
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Validator checks a fully parsed configuration value, returning a non-nil
+// error if cfg fails validation. Implementations are invoked after
+// defaulting and environment expansion, right before a parsed config is
+// handed back to the caller.
+type Validator[T any] interface {
+	Validate(cfg *T) error
+}
+
+// ValidatorFunc adapts a plain function to the Validator interface.
+type ValidatorFunc[T any] func(cfg *T) error
+
+func (f ValidatorFunc[T]) Validate(cfg *T) error {
+	return f(cfg)
+}
+
+// WithValidator runs v against every parsed configuration before it is
+// returned from ReadFile, ReadDirMap or ReadLayered.
+func WithValidator[T any](v Validator[T]) ConfigReaderOption[T] {
+	return func(cr *ConfigReader[T]) {
+		cr.validator = v
+	}
+}
+
+// WithDefaults registers a function that populates zero-value fields on a
+// freshly unmarshalled config before validation runs.
+func WithDefaults[T any](defaulter func(*T)) ConfigReaderOption[T] {
+	return func(cr *ConfigReader[T]) {
+		cr.defaulter = defaulter
+	}
+}
+
+// ValidationError wraps a Validator failure for a single file, so directory
+// scans can distinguish it from read/parse errors and aggregate it instead
+// of aborting the scan.
+type ValidationError struct {
+	Err error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("validation failed: %s", e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// DirValidationError aggregates every ValidationError encountered during a
+// single ReadDirMap/ReadDir scan, keyed by service name, so operators see
+// every bad file in one pass instead of one at a time.
+type DirValidationError struct {
+	Errors map[string]error
+}
+
+func (e *DirValidationError) Error() string {
+	names := make([]string, 0, len(e.Errors))
+	for name := range e.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s: %v", name, e.Errors[name]))
+	}
+
+	return fmt.Sprintf("validation failed for %d service(s): %s", len(e.Errors), strings.Join(parts, "; "))
+}
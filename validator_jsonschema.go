@@ -0,0 +1,72 @@
+//go:build jsonschema
+
+package cfgreader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// jsonSchemaValidator validates T by marshalling it back to JSON and
+// running it through a compiled JSON Schema. If schemaBytes failed to
+// compile, compileErr is set and every Validate call fails with it —
+// WithJSONSchema has no way to report a setup failure to its caller
+// directly, since ConfigReaderOption has no error return, so the failure
+// is deferred to the first validation instead of being silently dropped.
+type jsonSchemaValidator[T any] struct {
+	schema     *jsonschema.Schema
+	compileErr error
+}
+
+// WithJSONSchema compiles schemaBytes and validates every parsed config
+// against it: the config is marshalled back to JSON and checked before
+// being returned to the caller. A malformed schema is not rejected here
+// (options cannot fail) — instead every subsequent Validate call returns
+// the compile error, so a bad schema still surfaces as a load failure
+// instead of silently disabling validation.
+func WithJSONSchema[T any](schemaBytes []byte) ConfigReaderOption[T] {
+	return func(cr *ConfigReader[T]) {
+		compiler := jsonschema.NewCompiler()
+		const resourceName = "cfgreader-schema.json"
+		if err := compiler.AddResource(resourceName, bytes.NewReader(schemaBytes)); err != nil {
+			cr.logger.Error("failed to add JSON schema resource", slog.String("error", err.Error()))
+			cr.validator = &jsonSchemaValidator[T]{compileErr: fmt.Errorf("failed to add JSON schema resource: %w", err)}
+			return
+		}
+
+		schema, err := compiler.Compile(resourceName)
+		if err != nil {
+			cr.logger.Error("failed to compile JSON schema", slog.String("error", err.Error()))
+			cr.validator = &jsonSchemaValidator[T]{compileErr: fmt.Errorf("failed to compile JSON schema: %w", err)}
+			return
+		}
+
+		cr.validator = &jsonSchemaValidator[T]{schema: schema}
+	}
+}
+
+func (jv *jsonSchemaValidator[T]) Validate(cfg *T) error {
+	if jv.compileErr != nil {
+		return jv.compileErr
+	}
+
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to encode configuration for schema validation: %w", err)
+	}
+
+	var doc any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return fmt.Errorf("failed to decode configuration for schema validation: %w", err)
+	}
+
+	if err := jv.schema.Validate(doc); err != nil {
+		return fmt.Errorf("schema validation failed: %w", err)
+	}
+
+	return nil
+}
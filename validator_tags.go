@@ -0,0 +1,21 @@
+//go:build validator
+
+package cfgreader
+
+import "github.com/go-playground/validator/v10"
+
+// tagValidator validates T using `validate:"..."` struct tags, as read by
+// go-playground/validator.
+type tagValidator[T any] struct {
+	v *validator.Validate
+}
+
+// NewTagValidator builds a Validator that checks `validate:"required,url,..."`
+// struct tags on T via go-playground/validator.
+func NewTagValidator[T any]() Validator[T] {
+	return &tagValidator[T]{v: validator.New()}
+}
+
+func (tv *tagValidator[T]) Validate(cfg *T) error {
+	return tv.v.Struct(cfg)
+}
@@ -0,0 +1,176 @@
+package cfgreader
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// defaultReloadDebounce is used when WithReloadDebounce was never set.
+const defaultReloadDebounce = 250 * time.Millisecond
+
+// WithReloadDebounce sets how long Watch/WatchDir wait after the last
+// filesystem event in a burst before re-running the read pipeline and
+// invoking the caller's callback. This coalesces editors that emit several
+// events for a single logical save (e.g. rename-into-place).
+func WithReloadDebounce[T any](d time.Duration) ConfigReaderOption[T] {
+	return func(cr *ConfigReader[T]) {
+		cr.reloadDebounce = d
+	}
+}
+
+// Watch subscribes to changes under path (a single configuration file) and
+// invokes onChange with the result of re-running ReadFile every time a
+// debounced burst of filesystem events settles. Watch blocks until ctx is
+// cancelled or the underlying watcher fails irrecoverably.
+func (cr *ConfigReader[T]) Watch(ctx context.Context, path string, onChange func(*T, error)) error {
+	if _, ok := cr.source.(localSource); !ok {
+		return fmt.Errorf("Watch: source %T does not support filesystem watching; only the local filesystem source can be watched", cr.source)
+	}
+
+	if path == "" {
+		path = cr.defaultPath
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(path); err != nil {
+		return fmt.Errorf("failed to watch %q: %w", path, err)
+	}
+
+	return cr.runWatchLoop(ctx, watcher, func() {
+		cfg, err := cr.ReadFile(path)
+		onChange(cfg, err)
+	}, func() {
+		// Editors commonly save by renaming a temp file over the original,
+		// which looks like REMOVE/RENAME followed by CREATE. Re-adding the
+		// watch keeps it alive across that pattern.
+		_ = watcher.Remove(path)
+		if err := watcher.Add(path); err != nil {
+			cr.logger.Warn("failed to re-add watch after remove/rename",
+				slog.String("path", path),
+				slog.String("error", err.Error()))
+		}
+	})
+}
+
+// WatchDir subscribes to changes under dirPath and invokes onChange with
+// the result of re-running ReadDirMap every time a debounced burst of
+// filesystem events settles. If the reader was built WithRecursive(true),
+// every subdirectory present at call time is watched too.
+func (cr *ConfigReader[T]) WatchDir(ctx context.Context, dirPath string, onChange func(map[string]*T, error)) error {
+	if _, ok := cr.source.(localSource); !ok {
+		return fmt.Errorf("WatchDir: source %T does not support filesystem watching; only the local filesystem source can be watched", cr.source)
+	}
+
+	if dirPath == "" {
+		dirPath = cr.defaultPath
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := cr.addWatchDirs(watcher, dirPath); err != nil {
+		return err
+	}
+
+	return cr.runWatchLoop(ctx, watcher, func() {
+		configs, err := cr.ReadDirMap(dirPath)
+		onChange(configs, err)
+	}, func() {
+		_ = watcher.Remove(dirPath)
+		if err := cr.addWatchDirs(watcher, dirPath); err != nil {
+			cr.logger.Warn("failed to re-add watch after remove/rename",
+				slog.String("dir", dirPath),
+				slog.String("error", err.Error()))
+		}
+	})
+}
+
+// addWatchDirs adds dirPath, and every subdirectory when recursive mode is
+// on, to watcher.
+func (cr *ConfigReader[T]) addWatchDirs(watcher *fsnotify.Watcher, dirPath string) error {
+	if !cr.recursive {
+		if err := watcher.Add(dirPath); err != nil {
+			return fmt.Errorf("failed to watch %q: %w", dirPath, err)
+		}
+		return nil
+	}
+
+	return filepath.WalkDir(dirPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if err := watcher.Add(path); err != nil {
+				return fmt.Errorf("failed to watch %q: %w", path, err)
+			}
+		}
+		return nil
+	})
+}
+
+// runWatchLoop debounces fsnotify events and calls reload once a burst
+// settles. onRemoveOrRename is invoked synchronously for every
+// REMOVE/RENAME event, before debouncing, so the caller can re-establish
+// its watch(es) for editor-atomic-save patterns.
+func (cr *ConfigReader[T]) runWatchLoop(ctx context.Context, watcher *fsnotify.Watcher, reload func(), onRemoveOrRename func()) error {
+	debounce := cr.reloadDebounce
+	if debounce <= 0 {
+		debounce = defaultReloadDebounce
+	}
+
+	var timer *time.Timer
+	var timerC <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+				onRemoveOrRename()
+			}
+
+			if timer == nil {
+				timer = time.NewTimer(debounce)
+			} else {
+				if !timer.Stop() {
+					select {
+					case <-timer.C:
+					default:
+					}
+				}
+				timer.Reset(debounce)
+			}
+			timerC = timer.C
+
+		case <-timerC:
+			timerC = nil
+			reload()
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			cr.logger.Warn("watcher error", slog.String("error", err.Error()))
+		}
+	}
+}